@@ -5,9 +5,17 @@ package rtcp
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 )
 
+// appDefinedHeaderLength is the number of bytes preceding Data in a
+// marshaled ApplicationDefined packet (the 4-byte common header, SenderSSRC,
+// Name and MediaSSRC).
+const appDefinedHeaderLength = 16
+
+var errBufferTooSmall = errors.New("rtcp: buffer too small")
+
 // ApplicationDefined represents an RTCP application-defined packet.
 type ApplicationDefined struct {
 	SubType    uint8
@@ -15,6 +23,14 @@ type ApplicationDefined struct {
 	MediaSSRC  uint32
 	Name       string
 	Data       []byte
+
+	// Raw holds a reference to the bytes this packet was parsed from when
+	// populated via UnmarshalRaw. It is nil otherwise.
+	Raw []byte
+
+	// PayloadOffset is the offset of Data within Raw, set by UnmarshalRaw,
+	// so Data can be repacketized from Raw without copying.
+	PayloadOffset int
 }
 
 // DestinationSSRC returns the SSRC value for this packet.
@@ -24,46 +40,11 @@ func (a ApplicationDefined) DestinationSSRC() []uint32 {
 
 // Marshal serializes the application-defined struct into a byte slice with padding.
 func (a ApplicationDefined) Marshal() ([]byte, error) {
-	dataLength := len(a.Data)
-	if dataLength > 0xFFFF-16 {
-		return nil, errAppDefinedDataTooLarge
-	}
-	if len(a.Name) != 4 {
-		return nil, errAppDefinedInvalidName
-	}
-	// Calculate the padding size to be added to make the packet length a multiple of 4 bytes.
-	paddingSize := 4 - (dataLength % 4)
-	if paddingSize == 4 {
-		paddingSize = 0
-	}
-
-	packetSize := a.MarshalSize()
-	header := Header{
-		Type:    TypeApplicationDefined,
-		Length:  uint16((packetSize / 4) - 1),
-		Padding: paddingSize != 0,
-		Count:   a.SubType,
-	}
-
-	headerBytes, err := header.Marshal()
-	if err != nil {
+	rawPacket := make([]byte, a.MarshalSize())
+	if _, err := a.MarshalTo(rawPacket); err != nil {
 		return nil, err
 	}
 
-	rawPacket := make([]byte, packetSize)
-	copy(rawPacket, headerBytes)
-	binary.BigEndian.PutUint32(rawPacket[4:8], a.SenderSSRC)
-	copy(rawPacket[8:12], a.Name)
-	binary.BigEndian.PutUint32(rawPacket[12:16], a.MediaSSRC)
-	copy(rawPacket[16:], a.Data)
-
-	// Add padding if necessary.
-	if paddingSize > 0 {
-		for i := 0; i < paddingSize; i++ {
-			rawPacket[16+dataLength+i] = byte(paddingSize)
-		}
-	}
-
 	return rawPacket, nil
 }
 
@@ -116,6 +97,72 @@ func (a *ApplicationDefined) Unmarshal(rawPacket []byte) error {
 	return nil
 }
 
+// MarshalTo encodes the packet into buf, returning the number of bytes
+// written. It does not allocate, provided buf is at least MarshalSize()
+// bytes long.
+func (a ApplicationDefined) MarshalTo(buf []byte) (int, error) {
+	dataLength := len(a.Data)
+	if dataLength > 0xFFFF-16 {
+		return 0, errAppDefinedDataTooLarge
+	}
+	if len(a.Name) != 4 {
+		return 0, errAppDefinedInvalidName
+	}
+
+	packetSize := a.MarshalSize()
+	if len(buf) < packetSize {
+		return 0, errBufferTooSmall
+	}
+
+	// Calculate the padding size to be added to make the packet length a multiple of 4 bytes.
+	paddingSize := 4 - (dataLength % 4)
+	if paddingSize == 4 {
+		paddingSize = 0
+	}
+
+	header := Header{
+		Type:    TypeApplicationDefined,
+		Length:  uint16((packetSize / 4) - 1),
+		Padding: paddingSize != 0,
+		Count:   a.SubType,
+	}
+
+	headerBytes, err := header.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(buf, headerBytes)
+	binary.BigEndian.PutUint32(buf[4:8], a.SenderSSRC)
+	copy(buf[8:12], a.Name)
+	binary.BigEndian.PutUint32(buf[12:16], a.MediaSSRC)
+	copy(buf[appDefinedHeaderLength:], a.Data)
+
+	// Add padding if necessary.
+	if paddingSize > 0 {
+		for i := 0; i < paddingSize; i++ {
+			buf[appDefinedHeaderLength+dataLength+i] = byte(paddingSize)
+		}
+	}
+
+	return packetSize, nil
+}
+
+// UnmarshalRaw behaves like Unmarshal but additionally retains a reference
+// to rawPacket in Raw and records where Data begins within it in
+// PayloadOffset, so the caller can repacketize Data without copying the
+// original bytes.
+func (a *ApplicationDefined) UnmarshalRaw(rawPacket []byte) error {
+	if err := a.Unmarshal(rawPacket); err != nil {
+		return err
+	}
+
+	a.Raw = rawPacket
+	a.PayloadOffset = appDefinedHeaderLength
+
+	return nil
+}
+
 // MarshalSize returns the size of the packet once marshaled
 func (a *ApplicationDefined) MarshalSize() int {
 	dataLength := len(a.Data)
@@ -131,4 +178,4 @@ func (a ApplicationDefined) String() string {
 	out := fmt.Sprintf("ApplicationDefined from %x\n", a.SenderSSRC)
 	out += fmt.Sprintf("Subtype: %d, Name: %s, MediaSSRC:%x, Data:0x%X", a.SubType, a.Name, a.MediaSSRC, a.Data)
 	return out
-}
\ No newline at end of file
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import (
+	"errors"
+	"testing"
+)
+
+type testAppPayload struct {
+	Value uint8
+}
+
+func (p *testAppPayload) Marshal(buf []byte) (int, error) {
+	if len(buf) < 1 {
+		return 0, errBufferTooSmall
+	}
+	buf[0] = p.Value
+	return 1, nil
+}
+
+func (p *testAppPayload) Unmarshal(buf []byte) error {
+	if len(buf) < 1 {
+		return errRembPayloadTooShort
+	}
+	p.Value = buf[0]
+	return nil
+}
+
+func TestDecodePayloadUnregistered(t *testing.T) {
+	appDefined := ApplicationDefined{Name: "NONE", Data: []byte{0x01}}
+	if _, err := appDefined.DecodePayload(); !errors.Is(err, errApplicationDefinedCodecNotRegistered) {
+		t.Fatalf("DecodePayload = %v, want errApplicationDefinedCodecNotRegistered", err)
+	}
+}
+
+func TestRegisterApplicationDefinedCodecAndDecodePayload(t *testing.T) {
+	RegisterApplicationDefinedCodec("TPAY", func() ApplicationDefinedPayload { return &testAppPayload{} })
+
+	appDefined := ApplicationDefined{Name: "TPAY", Data: []byte{0x2A}}
+	payload, err := appDefined.DecodePayload()
+	if err != nil {
+		t.Fatalf("DecodePayload: %v", err)
+	}
+
+	decoded, ok := payload.(*testAppPayload)
+	if !ok {
+		t.Fatalf("DecodePayload returned %T, want *testAppPayload", payload)
+	}
+	if decoded.Value != 0x2A {
+		t.Fatalf("decoded.Value = %#x, want 0x2A", decoded.Value)
+	}
+}
+
+func TestUnmarshalApplicationDefinedDispatchesRegisteredName(t *testing.T) {
+	RegisterApplicationDefinedCodec("TPAY", func() ApplicationDefinedPayload { return &testAppPayload{} })
+
+	raw, err := ApplicationDefined{Name: "TPAY", Data: []byte{0x07}}.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	appDefined, payload, err := UnmarshalApplicationDefined(raw)
+	if err != nil {
+		t.Fatalf("UnmarshalApplicationDefined: %v", err)
+	}
+	if appDefined.Name != "TPAY" {
+		t.Fatalf("appDefined.Name = %q, want TPAY", appDefined.Name)
+	}
+
+	decoded, ok := payload.(*testAppPayload)
+	if !ok {
+		t.Fatalf("payload = %T, want *testAppPayload", payload)
+	}
+	if decoded.Value != 0x07 {
+		t.Fatalf("decoded.Value = %#x, want 0x07", decoded.Value)
+	}
+}
+
+func TestUnmarshalApplicationDefinedNoCodecRegistered(t *testing.T) {
+	raw, err := ApplicationDefined{Name: "NONE", Data: []byte{0x01}}.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	appDefined, payload, err := UnmarshalApplicationDefined(raw)
+	if err != nil {
+		t.Fatalf("UnmarshalApplicationDefined: %v", err)
+	}
+	if payload != nil {
+		t.Fatalf("payload = %v, want nil", payload)
+	}
+	if appDefined.Name != "NONE" {
+		t.Fatalf("appDefined.Name = %q, want NONE", appDefined.Name)
+	}
+}
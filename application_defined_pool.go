@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import "sync"
+
+// defaultApplicationDefinedPoolBufferSize is the buffer size new pool
+// entries start out with. It covers a typical MTU-sized APP packet (the
+// common case for the REMB/BWE-style messages this pool targets) without
+// pre-allocating up to the 0xFFFF maximum every packet is technically
+// allowed to reach. Packets that don't fit get a larger, one-off buffer
+// instead of being put back in the pool, trading a per-packet allocation
+// for oversized payloads against not holding maximum-sized memory for
+// every pool entry.
+const defaultApplicationDefinedPoolBufferSize = 1500
+
+var applicationDefinedBufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, defaultApplicationDefinedPoolBufferSize)
+		return &buf
+	},
+}
+
+// MarshalPooled encodes the packet using a []byte borrowed from a shared
+// sync.Pool instead of allocating, and returns a release func that must be
+// called once the caller is done with the returned bytes (typically after
+// the write syscall completes). A packet larger than the pooled buffer's
+// capacity is encoded into a newly allocated buffer, which is not returned
+// to the pool.
+func (a ApplicationDefined) MarshalPooled() (data []byte, release func(), err error) {
+	size := a.MarshalSize()
+
+	bufPtr, _ := applicationDefinedBufferPool.Get().(*[]byte)
+	pooled := bufPtr
+	if cap(*bufPtr) < size {
+		grown := make([]byte, size)
+		bufPtr = &grown
+	} else {
+		*bufPtr = (*bufPtr)[:size]
+	}
+
+	n, err := a.MarshalTo(*bufPtr)
+	if err != nil {
+		applicationDefinedBufferPool.Put(pooled)
+		return nil, nil, err
+	}
+
+	return (*bufPtr)[:n], func() { applicationDefinedBufferPool.Put(pooled) }, nil
+}
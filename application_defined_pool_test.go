@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestApplicationDefinedMarshalPooled(t *testing.T) {
+	appDefined := ApplicationDefined{
+		SubType:    1,
+		SenderSSRC: 0x11111111,
+		MediaSSRC:  0x22222222,
+		Name:       "TEST",
+		Data:       []byte{0x01, 0x02, 0x03},
+	}
+
+	want, err := appDefined.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	data, release, err := appDefined.MarshalPooled()
+	if err != nil {
+		t.Fatalf("MarshalPooled: %v", err)
+	}
+	defer release()
+
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalPooled = % x, want % x", data, want)
+	}
+}
+
+func TestApplicationDefinedMarshalPooledGrowsForLargePacket(t *testing.T) {
+	appDefined := ApplicationDefined{
+		Name: "TEST",
+		Data: make([]byte, defaultApplicationDefinedPoolBufferSize+4),
+	}
+
+	data, release, err := appDefined.MarshalPooled()
+	if err != nil {
+		t.Fatalf("MarshalPooled: %v", err)
+	}
+	defer release()
+
+	if len(data) != appDefined.MarshalSize() {
+		t.Fatalf("MarshalPooled returned %d bytes, want %d", len(data), appDefined.MarshalSize())
+	}
+}
+
+func TestApplicationDefinedMarshalPooledReturnsBufferOnError(t *testing.T) {
+	pooled, _ := applicationDefinedBufferPool.Get().(*[]byte)
+	applicationDefinedBufferPool.Put(pooled)
+
+	invalid := ApplicationDefined{Name: "TOOLONGNAME"}
+	if _, _, err := invalid.MarshalPooled(); err == nil {
+		t.Fatalf("MarshalPooled with an invalid Name should have failed")
+	}
+
+	got, _ := applicationDefinedBufferPool.Get().(*[]byte)
+	if got != pooled {
+		t.Fatalf("MarshalPooled dropped the pooled buffer on the reuse path instead of returning it on error")
+	}
+	applicationDefinedBufferPool.Put(got)
+}
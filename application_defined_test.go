@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestApplicationDefinedMarshalTo(t *testing.T) {
+	appDefined := ApplicationDefined{
+		SubType:    1,
+		SenderSSRC: 0x11111111,
+		MediaSSRC:  0x22222222,
+		Name:       "TEST",
+		Data:       []byte{0x01, 0x02, 0x03},
+	}
+
+	want, err := appDefined.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	buf := make([]byte, appDefined.MarshalSize())
+	n, err := appDefined.MarshalTo(buf)
+	if err != nil {
+		t.Fatalf("MarshalTo: %v", err)
+	}
+	if n != len(want) {
+		t.Fatalf("MarshalTo wrote %d bytes, want %d", n, len(want))
+	}
+	if !bytes.Equal(buf[:n], want) {
+		t.Fatalf("MarshalTo = % x, want % x", buf[:n], want)
+	}
+
+	var decoded ApplicationDefined
+	if err := decoded.Unmarshal(buf[:n]); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.SubType != appDefined.SubType ||
+		decoded.SenderSSRC != appDefined.SenderSSRC ||
+		decoded.MediaSSRC != appDefined.MediaSSRC ||
+		decoded.Name != appDefined.Name ||
+		!bytes.Equal(decoded.Data, appDefined.Data) {
+		t.Fatalf("round trip mismatch: got %+v, want %+v", decoded, appDefined)
+	}
+}
+
+func TestApplicationDefinedMarshalToBufferTooSmall(t *testing.T) {
+	appDefined := ApplicationDefined{
+		Name: "TEST",
+		Data: []byte{0x01, 0x02, 0x03, 0x04},
+	}
+
+	buf := make([]byte, appDefined.MarshalSize()-1)
+	if _, err := appDefined.MarshalTo(buf); err != errBufferTooSmall {
+		t.Fatalf("MarshalTo = %v, want errBufferTooSmall", err)
+	}
+}
+
+func TestApplicationDefinedUnmarshalRaw(t *testing.T) {
+	appDefined := ApplicationDefined{
+		SubType:    2,
+		SenderSSRC: 0x33333333,
+		MediaSSRC:  0x44444444,
+		Name:       "TEST",
+		Data:       []byte{0xAA, 0xBB},
+	}
+
+	raw, err := appDefined.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded ApplicationDefined
+	if err := decoded.UnmarshalRaw(raw); err != nil {
+		t.Fatalf("UnmarshalRaw: %v", err)
+	}
+
+	if decoded.PayloadOffset != appDefinedHeaderLength {
+		t.Fatalf("PayloadOffset = %d, want %d", decoded.PayloadOffset, appDefinedHeaderLength)
+	}
+	if &decoded.Raw[0] != &raw[0] {
+		t.Fatalf("Raw does not reference the original backing array")
+	}
+	if !bytes.Equal(decoded.Raw[decoded.PayloadOffset:decoded.PayloadOffset+len(appDefined.Data)], appDefined.Data) {
+		t.Fatalf("Raw[PayloadOffset:] does not match Data")
+	}
+}
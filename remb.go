@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// rembUniqueIdentifier is the ApplicationDefined Name used for REMB
+// packets, as specified in
+// https://datatracker.ietf.org/doc/html/draft-alvestrand-rmcat-remb-03.
+const rembUniqueIdentifier = "REMB"
+
+// rembMaxMantissa is the largest value representable in the 18-bit BR
+// Mantissa field.
+const rembMaxMantissa = 0x3FFFF
+
+var (
+	errRembPayloadTooShort   = errors.New("rtcp: REMB payload too short")
+	errRembSSRCCountMismatch = errors.New("rtcp: REMB payload length does not match its SSRC count")
+	errRembTooManySSRCs      = errors.New("rtcp: REMB SSRC count exceeds the 8-bit Num SSRC field")
+)
+
+func init() {
+	RegisterApplicationDefinedCodec(rembUniqueIdentifier, func() ApplicationDefinedPayload {
+		return &RembApp{}
+	})
+}
+
+// RembApp is a Receiver Estimated Max Bitrate report. It is carried as the
+// Data of an ApplicationDefined packet with Name "REMB", giving receivers a
+// way to signal a congestion-controlled bitrate estimate for one or more
+// media SSRCs without hand-rolling the exponent/mantissa packing.
+type RembApp struct {
+	// Bitrate is the estimated maximum total bitrate in bits per second.
+	// It is packed into a 6-bit exponent and 18-bit mantissa on Marshal,
+	// so values it cannot represent exactly are rounded down.
+	Bitrate uint64
+
+	// SSRCs lists the media SSRCs this estimate applies to.
+	SSRCs []uint32
+}
+
+// ApplicationDefined returns r encoded as an ApplicationDefined packet with
+// the registered "REMB" Name, ready to Marshal and send.
+func (r RembApp) ApplicationDefined(senderSSRC uint32) (ApplicationDefined, error) {
+	data := make([]byte, r.MarshalSize())
+	if _, err := r.Marshal(data); err != nil {
+		return ApplicationDefined{}, err
+	}
+
+	return ApplicationDefined{
+		SenderSSRC: senderSSRC,
+		Name:       rembUniqueIdentifier,
+		Data:       data,
+	}, nil
+}
+
+// MarshalSize returns the size of the REMB payload once marshaled.
+func (r RembApp) MarshalSize() int {
+	return 4 + 4*len(r.SSRCs)
+}
+
+// Marshal encodes the REMB payload into buf, returning the number of bytes
+// written.
+func (r RembApp) Marshal(buf []byte) (int, error) {
+	if len(r.SSRCs) > 0xFF {
+		return 0, errRembTooManySSRCs
+	}
+
+	size := r.MarshalSize()
+	if len(buf) < size {
+		return 0, errBufferTooSmall
+	}
+
+	exp, mantissa := rembBitrateToExpMantissa(r.Bitrate)
+
+	buf[0] = byte(len(r.SSRCs))
+	buf[1] = (exp << 2) | byte(mantissa>>16)
+	buf[2] = byte(mantissa >> 8)
+	buf[3] = byte(mantissa)
+
+	for i, ssrc := range r.SSRCs {
+		binary.BigEndian.PutUint32(buf[4+4*i:], ssrc)
+	}
+
+	return size, nil
+}
+
+// Unmarshal parses the given bytes into the REMB payload.
+func (r *RembApp) Unmarshal(buf []byte) error {
+	if len(buf) < 4 {
+		return errRembPayloadTooShort
+	}
+
+	numSSRC := int(buf[0])
+	if len(buf) != 4+4*numSSRC {
+		return errRembSSRCCountMismatch
+	}
+
+	exp := buf[1] >> 2
+	mantissa := uint32(buf[1]&0x03)<<16 | uint32(buf[2])<<8 | uint32(buf[3])
+	r.Bitrate = uint64(mantissa) << exp
+
+	r.SSRCs = make([]uint32, numSSRC)
+	for i := range r.SSRCs {
+		r.SSRCs[i] = binary.BigEndian.Uint32(buf[4+4*i:])
+	}
+
+	return nil
+}
+
+// rembBitrateToExpMantissa packs bitrate into the 6-bit exponent/18-bit
+// mantissa pair used by the REMB wire format, rounding down when bitrate
+// cannot be represented exactly.
+func rembBitrateToExpMantissa(bitrate uint64) (exp uint8, mantissa uint32) {
+	for bitrate > rembMaxMantissa {
+		bitrate >>= 1
+		exp++
+	}
+
+	return exp, uint32(bitrate)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2023 The Pion community <https://pion.ly>
+// SPDX-License-Identifier: MIT
+
+package rtcp
+
+import "testing"
+
+func TestRembAppRoundTrip(t *testing.T) {
+	cases := map[string]RembApp{
+		"single SSRC": {
+			Bitrate: 1000000,
+			SSRCs:   []uint32{0x11111111},
+		},
+		"multiple SSRCs": {
+			Bitrate: 5000000000,
+			SSRCs:   []uint32{0x11111111, 0x22222222, 0x33333333},
+		},
+		"zero SSRCs": {
+			Bitrate: 42,
+			SSRCs:   nil,
+		},
+	}
+
+	for name, remb := range cases {
+		remb := remb
+		t.Run(name, func(t *testing.T) {
+			buf := make([]byte, remb.MarshalSize())
+			n, err := remb.Marshal(buf)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if n != len(buf) {
+				t.Fatalf("Marshal wrote %d bytes, want %d", n, len(buf))
+			}
+
+			var decoded RembApp
+			if err := decoded.Unmarshal(buf); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			if decoded.Bitrate != rembExpectedBitrate(remb.Bitrate) {
+				t.Fatalf("decoded.Bitrate = %d, want %d", decoded.Bitrate, rembExpectedBitrate(remb.Bitrate))
+			}
+			if len(decoded.SSRCs) != len(remb.SSRCs) {
+				t.Fatalf("decoded.SSRCs = %v, want %v", decoded.SSRCs, remb.SSRCs)
+			}
+			for i := range remb.SSRCs {
+				if decoded.SSRCs[i] != remb.SSRCs[i] {
+					t.Fatalf("decoded.SSRCs[%d] = %#x, want %#x", i, decoded.SSRCs[i], remb.SSRCs[i])
+				}
+			}
+		})
+	}
+}
+
+// rembExpectedBitrate accounts for the rounding the 18-bit mantissa / 6-bit
+// exponent encoding applies to bitrates that aren't exactly representable.
+func rembExpectedBitrate(bitrate uint64) uint64 {
+	exp, mantissa := rembBitrateToExpMantissa(bitrate)
+	return uint64(mantissa) << exp
+}
+
+func TestRembAppApplicationDefined(t *testing.T) {
+	remb := RembApp{Bitrate: 2000000, SSRCs: []uint32{0xAAAAAAAA}}
+
+	appDefined, err := remb.ApplicationDefined(0x12345678)
+	if err != nil {
+		t.Fatalf("ApplicationDefined: %v", err)
+	}
+	if appDefined.Name != rembUniqueIdentifier {
+		t.Fatalf("appDefined.Name = %q, want %q", appDefined.Name, rembUniqueIdentifier)
+	}
+	if appDefined.SenderSSRC != 0x12345678 {
+		t.Fatalf("appDefined.SenderSSRC = %#x, want 0x12345678", appDefined.SenderSSRC)
+	}
+
+	var decoded RembApp
+	if err := decoded.Unmarshal(appDefined.Data); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(decoded.SSRCs) != 1 || decoded.SSRCs[0] != 0xAAAAAAAA {
+		t.Fatalf("decoded.SSRCs = %v, want [0xAAAAAAAA]", decoded.SSRCs)
+	}
+}
+
+func TestRembAppMarshalTooManySSRCs(t *testing.T) {
+	remb := RembApp{SSRCs: make([]uint32, 0x100)}
+	if _, err := remb.Marshal(make([]byte, remb.MarshalSize())); err != errRembTooManySSRCs {
+		t.Fatalf("Marshal = %v, want errRembTooManySSRCs", err)
+	}
+}
+
+func TestRembAppUnmarshalPayloadTooShort(t *testing.T) {
+	var remb RembApp
+	if err := remb.Unmarshal([]byte{0x00, 0x00, 0x00}); err != errRembPayloadTooShort {
+		t.Fatalf("Unmarshal = %v, want errRembPayloadTooShort", err)
+	}
+}
+
+func TestRembAppUnmarshalSSRCCountMismatch(t *testing.T) {
+	// NumSSRC says 2 but only one SSRC's worth of bytes follows the
+	// exponent/mantissa header.
+	buf := []byte{0x02, 0x00, 0x00, 0x00, 0x11, 0x11, 0x11, 0x11}
+
+	var remb RembApp
+	if err := remb.Unmarshal(buf); err != errRembSSRCCountMismatch {
+		t.Fatalf("Unmarshal = %v, want errRembSSRCCountMismatch", err)
+	}
+}